@@ -5,6 +5,16 @@ CHECKOUT_DIR=$(pwd)
 
 source build.env
 
+: ${APPLY_MASKED_PATHS_PATCH:=yes}
+
+case "${APPLY_MASKED_PATHS_PATCH}" in
+  yes|no) ;;
+  *)
+    echo "APPLY_MASKED_PATHS_PATCH must be \"yes\" or \"no\", got \"${APPLY_MASKED_PATHS_PATCH}\"" >&2
+    exit 1
+    ;;
+esac
+
 # Everything happens within scripts/
 pushd scripts
 
@@ -23,8 +33,11 @@ if [ ! -d cri-o ]; then
 fi
 pushd cri-o
 git fetch origin
-git checkout ${CRIO_VERSION_TAG}
-git apply ${CHECKOUT_DIR}/masked-paths.patch
+# Force the checkout so a reused tree never keeps a previously applied patch
+git checkout -f ${CRIO_VERSION_TAG}
+if [ "${APPLY_MASKED_PATHS_PATCH}" = "yes" ]; then
+  git apply ${CHECKOUT_DIR}/masked-paths.patch
+fi
 popd
 
 if [ ! -d conmon ]; then